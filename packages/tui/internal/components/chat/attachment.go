@@ -0,0 +1,253 @@
+package chat
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// AttachmentSource describes where an Attachment's bytes came from, so the
+// UI can render an appropriate label (a path, a URL, "clipboard", ...)
+// without re-deriving it from MediaType.
+type AttachmentSource struct {
+	Kind string // "file", "url", "data", "clipboard"
+	Ref  string // path, URL, or other human-readable reference
+}
+
+// Attachment is the normalized result of an AttachmentProvider resolving a
+// piece of pasted or completed content.
+type Attachment struct {
+	MediaType string
+	Source    AttachmentSource
+	Inline    []byte // populated when the provider has the bytes in hand
+}
+
+// AttachmentProvider resolves a raw string (a pasted path, URL, data URI,
+// or clipboard reference) into an Attachment. CanHandle should be cheap and
+// side-effect free; Resolve does the actual read/fetch/decode.
+type AttachmentProvider interface {
+	CanHandle(raw string) bool
+	Resolve(raw string) (Attachment, error)
+}
+
+var attachmentProviders []AttachmentProvider
+
+// RegisterAttachmentProvider adds a provider to the set consulted when
+// resolving pasted or completed content. Plugins call this from init() to
+// extend what the editor can attach.
+func RegisterAttachmentProvider(p AttachmentProvider) {
+	attachmentProviders = append(attachmentProviders, p)
+}
+
+func init() {
+	RegisterAttachmentProvider(localFileAttachmentProvider{})
+	RegisterAttachmentProvider(httpAttachmentProvider{})
+	RegisterAttachmentProvider(dataURIAttachmentProvider{})
+}
+
+// resolveAttachment walks the registered providers in order and returns the
+// first one willing to handle raw. handled is false when no provider
+// claims it.
+func resolveAttachment(raw string) (Attachment, bool, error) {
+	for _, p := range attachmentProviders {
+		if p.CanHandle(raw) {
+			att, err := p.Resolve(raw)
+			return att, true, err
+		}
+	}
+	return Attachment{}, false, nil
+}
+
+// sniffMediaType returns ext's extension-based guess, falling back to
+// http.DetectContentType on the first 512 bytes of data when ext is empty
+// or mimeByExt has no entry for it. data is not checked against a known
+// extension, so a wrong known extension still decides the result.
+func sniffMediaType(ext string, mimeByExt func(string) string, data []byte) string {
+	if ext != "" {
+		if mt := mimeByExt(ext); mt != "" {
+			return mt
+		}
+	}
+	if len(data) > 512 {
+		data = data[:512]
+	}
+	return http.DetectContentType(data)
+}
+
+// mimeByExtension maps a file extension (as returned by filepath.Ext, dot
+// included) to its MIME type. sniffMediaType falls back to
+// http.DetectContentType when an extension is missing from this table, or
+// absent from raw entirely.
+func mimeByExtension(ext string) string {
+	switch strings.ToLower(ext) {
+	case ".png":
+		return "image/png"
+	case ".jpg", ".jpeg":
+		return "image/jpeg"
+	case ".gif":
+		return "image/gif"
+	case ".webp":
+		return "image/webp"
+	case ".pdf":
+		return "application/pdf"
+	case ".txt":
+		return "text/plain"
+	case ".md":
+		return "text/markdown"
+	default:
+		return ""
+	}
+}
+
+type localFileAttachmentProvider struct{}
+
+func (localFileAttachmentProvider) CanHandle(raw string) bool {
+	return strings.HasPrefix(raw, "/") || strings.HasPrefix(raw, "~/") || strings.HasPrefix(raw, "./")
+}
+
+func (localFileAttachmentProvider) Resolve(raw string) (Attachment, error) {
+	path, err := expandPath(raw)
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Attachment{}, fmt.Errorf("reading %s: %w", raw, err)
+	}
+
+	return Attachment{
+		MediaType: sniffMediaType(filepath.Ext(raw), mimeByExtension, data),
+		Source:    AttachmentSource{Kind: "file", Ref: raw},
+		Inline:    data,
+	}, nil
+}
+
+// expandPath resolves a leading "~/" against the user's home directory;
+// every other form of raw is already a usable filesystem path.
+func expandPath(raw string) (string, error) {
+	if !strings.HasPrefix(raw, "~/") {
+		return raw, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, strings.TrimPrefix(raw, "~/")), nil
+}
+
+// maxAttachmentFetchBytes caps how much of a remote URL's body
+// httpAttachmentProvider will read, so a large or unbounded response can't
+// be pulled entirely into memory.
+const maxAttachmentFetchBytes = 10 * 1024 * 1024
+
+var attachmentHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+type httpAttachmentProvider struct{}
+
+func (httpAttachmentProvider) CanHandle(raw string) bool {
+	return strings.HasPrefix(raw, "http://") || strings.HasPrefix(raw, "https://")
+}
+
+func (httpAttachmentProvider) Resolve(raw string) (Attachment, error) {
+	resp, err := attachmentHTTPClient.Get(raw)
+	if err != nil {
+		return Attachment{}, fmt.Errorf("fetching %s: %w", raw, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Attachment{}, fmt.Errorf("fetching %s: unexpected status %s", raw, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxAttachmentFetchBytes))
+	if err != nil {
+		return Attachment{}, fmt.Errorf("reading %s: %w", raw, err)
+	}
+
+	mediaType := ""
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		if parsed, _, err := mime.ParseMediaType(contentType); err == nil {
+			mediaType = parsed
+		}
+	}
+	if mediaType == "" {
+		mediaType = sniffMediaType(filepath.Ext(raw), mimeByExtension, data)
+	}
+
+	return Attachment{
+		MediaType: mediaType,
+		Source:    AttachmentSource{Kind: "url", Ref: raw},
+		Inline:    data,
+	}, nil
+}
+
+type dataURIAttachmentProvider struct{}
+
+func (dataURIAttachmentProvider) CanHandle(raw string) bool {
+	return strings.HasPrefix(raw, "data:")
+}
+
+func (dataURIAttachmentProvider) Resolve(raw string) (Attachment, error) {
+	rest, ok := strings.CutPrefix(raw, "data:")
+	if !ok {
+		return Attachment{}, nil
+	}
+	meta, payload, ok := strings.Cut(rest, ",")
+	if !ok {
+		return Attachment{}, nil
+	}
+	mediaType, isBase64 := strings.CutSuffix(meta, ";base64")
+	if mediaType == "" {
+		mediaType = "text/plain"
+	}
+
+	var data []byte
+	var err error
+	if isBase64 {
+		data, err = base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return Attachment{}, err
+		}
+	} else {
+		data = []byte(payload)
+	}
+
+	return Attachment{
+		MediaType: mediaType,
+		Source:    AttachmentSource{Kind: "data", Ref: mediaType},
+		Inline:    data,
+	}, nil
+}
+
+// clipboardImageAttachmentProvider resolves inline image bytes (rather than
+// a path) pasted from the clipboard. It is not registered with
+// RegisterAttachmentProvider, and its CanHandle never claims raw text, so
+// resolveAttachment never selects it; it is resolved by calling Resolve on
+// a value built with the pasted bytes.
+type clipboardImageAttachmentProvider struct {
+	mediaType string
+	data      []byte
+}
+
+func (clipboardImageAttachmentProvider) CanHandle(raw string) bool {
+	return false // selected explicitly by the paste handler, not by raw text
+}
+
+func (p clipboardImageAttachmentProvider) Resolve(string) (Attachment, error) {
+	mediaType := p.mediaType
+	if mediaType == "" {
+		mediaType = sniffMediaType("", func(string) string { return "" }, p.data)
+	}
+	return Attachment{
+		MediaType: mediaType,
+		Source:    AttachmentSource{Kind: "clipboard"},
+		Inline:    p.data,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package chat
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSniffMediaType_PrefersExtension(t *testing.T) {
+	mt := sniffMediaType(".png", mimeByExtension, []byte("not actually a png"))
+	if mt != "image/png" {
+		t.Fatalf("expected image/png from extension, got %q", mt)
+	}
+}
+
+func TestSniffMediaType_FallsBackToContentWhenExtensionUnknown(t *testing.T) {
+	mt := sniffMediaType(".xyz", mimeByExtension, []byte("%PDF-1.4"))
+	if mt != "application/pdf" {
+		t.Fatalf("expected application/pdf from content sniff, got %q", mt)
+	}
+}
+
+func TestSniffMediaType_FallsBackToContentWhenExtensionMissing(t *testing.T) {
+	mt := sniffMediaType("", mimeByExtension, []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a})
+	if mt != "image/png" {
+		t.Fatalf("expected image/png from content sniff, got %q", mt)
+	}
+}
+
+func TestResolveAttachment_LocalFile_UsesExtensionMime(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, "doc.pdf")
+	if err := os.WriteFile(p, []byte("%PDF-1.4"), 0o600); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+
+	att, handled, err := resolveAttachment(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !handled {
+		t.Fatalf("expected a provider to claim %q", p)
+	}
+	if att.MediaType != "application/pdf" {
+		t.Fatalf("expected application/pdf mime, got %q", att.MediaType)
+	}
+}
+
+func TestResolveAttachment_LocalFile_SniffsExtensionlessFile(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, "noext")
+	if err := os.WriteFile(p, []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a}, 0o600); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+
+	att, handled, err := resolveAttachment(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !handled {
+		t.Fatalf("expected a provider to claim %q", p)
+	}
+	if att.MediaType != "image/png" {
+		t.Fatalf("expected image/png mime from content sniff, got %q", att.MediaType)
+	}
+}
+
+func TestResolveAttachment_NoProviderClaimsPlainText(t *testing.T) {
+	_, handled, err := resolveAttachment("hello world")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handled {
+		t.Fatalf("expected no provider to claim plain text")
+	}
+}
+
+func TestResolveAttachment_HTTP_UsesContentTypeHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.4"))
+	}))
+	defer server.Close()
+
+	att, handled, err := resolveAttachment(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !handled {
+		t.Fatalf("expected a provider to claim %q", server.URL)
+	}
+	if att.MediaType != "application/pdf" {
+		t.Fatalf("expected application/pdf from Content-Type header, got %q", att.MediaType)
+	}
+	if string(att.Inline) != "%PDF-1.4" {
+		t.Fatalf("expected fetched body in Inline, got %q", att.Inline)
+	}
+}
+
+func TestResolveAttachment_HTTP_SniffsContentWhenHeaderAbsent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A nil value stops net/http from sniffing and setting the header itself.
+		w.Header()["Content-Type"] = nil
+		w.Write([]byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a})
+	}))
+	defer server.Close()
+
+	att, handled, err := resolveAttachment(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !handled {
+		t.Fatalf("expected a provider to claim %q", server.URL)
+	}
+	if att.MediaType != "image/png" {
+		t.Fatalf("expected image/png from content sniff, got %q", att.MediaType)
+	}
+}
+
+func TestResolveAttachment_HTTP_ErrorsOnNonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, _, err := resolveAttachment(server.URL)
+	if err == nil {
+		t.Fatalf("expected an error for a non-OK status")
+	}
+}
@@ -3,10 +3,10 @@ package dialog
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/v2/key"
 	tea "github.com/charmbracelet/bubbletea/v2"
-	"github.com/lithammer/fuzzysearch/fuzzy"
 	"github.com/sst/opencode-sdk-go"
 	"github.com/sst/opencode/internal/app"
 	"github.com/sst/opencode/internal/components/list"
@@ -15,6 +15,7 @@ import (
 	"github.com/sst/opencode/internal/styles"
 	"github.com/sst/opencode/internal/theme"
 	"github.com/sst/opencode/internal/util"
+	"github.com/sst/opencode/internal/util/fuzzymatch"
 )
 
 const (
@@ -22,6 +23,10 @@ const (
 	minAgentDialogWidth  = 54
 	maxAgentDialogWidth  = 108
 	maxDescriptionLength = 80
+	// maxAgentDialogHeightPercent bounds the inline picker to a fraction of
+	// the terminal height on large terminals, fzf's "--height 40%" style,
+	// instead of always taking over the whole screen.
+	maxAgentDialogHeightPercent = 40
 )
 
 // AgentDialog interface for the agent selection dialog
@@ -37,11 +42,26 @@ type agentDialog struct {
 	modal        *modal.Modal
 	searchDialog *SearchDialog
 	dialogWidth  int
+	resize       *layout.Coalescer
+}
+
+// SetSize applies a coalesced resize to the dialog, implementing
+// layout.Resizable so a.resize can flush a burst of tea.WindowSizeMsg
+// into a single call.
+func (a *agentDialog) SetSize(width, height int) tea.Cmd {
+	a.width = width
+	a.height = height
+	a.searchDialog.SetWidth(a.dialogWidth)
+	a.searchDialog.SetHeight(a.inlineHeight(height))
+	return nil
 }
 
 // agentItem is a custom list item for agent selections
 type agentItem struct {
 	agent opencode.Agent
+	// nameMatches holds rune indices into agent.Name that matched the
+	// current search query, so Render can bold them. Empty outside search.
+	nameMatches []int
 }
 
 func (a agentItem) Render(
@@ -83,7 +103,7 @@ func (a agentItem) Render(
 		description = description[:descriptionMaxLength-3] + "..."
 	}
 
-	namePart := itemStyle.Render(agentName)
+	namePart := a.renderName(agentName, itemStyle)
 	descPart := descStyle.Render(separator + description)
 	combinedText := namePart + descPart
 
@@ -94,6 +114,31 @@ func (a agentItem) Render(
 		Render(combinedText)
 }
 
+// renderName bolds the runes of name that matched the search query,
+// leaving the rest styled normally.
+func (a agentItem) renderName(name string, base styles.Style) string {
+	if len(a.nameMatches) == 0 {
+		return base.Render(name)
+	}
+
+	matched := make(map[int]bool, len(a.nameMatches))
+	for _, pos := range a.nameMatches {
+		matched[pos] = true
+	}
+
+	boldStyle := base.Bold(true)
+
+	var b strings.Builder
+	for i, r := range []rune(name) {
+		if matched[i] {
+			b.WriteString(boldStyle.Render(string(r)))
+		} else {
+			b.WriteString(base.Render(string(r)))
+		}
+	}
+	return b.String()
+}
+
 func (a agentItem) Selectable() bool {
 	// All agents in the dialog are selectable (subagents are filtered out)
 	return true
@@ -144,10 +189,15 @@ func (a *agentDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return a, nil
 
 	case tea.WindowSizeMsg:
-		a.width = msg.Width
-		a.height = msg.Height
-		a.searchDialog.SetWidth(a.dialogWidth)
-		a.searchDialog.SetHeight(msg.Height)
+		// Only queue the debounced resize here; forwarding the raw message
+		// to a.searchDialog as well would re-lay it out on every single
+		// event during a drag, defeating the coalescer. SetSize (called by
+		// a.resize.Flush below) is what actually resizes the child dialog.
+		return a, a.resize.Handle(msg)
+	}
+
+	if cmd := a.resize.Flush(msg); cmd != nil {
+		return a, cmd
 	}
 
 	updatedDialog, cmd := a.searchDialog.Update(msg)
@@ -159,6 +209,13 @@ func (a *agentDialog) View() string {
 	return a.searchDialog.View()
 }
 
+// inlineHeight caps the picker to min(numVisibleAgents+4, configured%) rows
+// of the available terminal height, rather than always filling the screen.
+func (a *agentDialog) inlineHeight(termHeight int) int {
+	percentRows := termHeight * maxAgentDialogHeightPercent / 100
+	return min(numVisibleAgents+4, percentRows)
+}
+
 func (a *agentDialog) calculateOptimalWidth(agents []opencode.Agent) int {
 	maxWidth := minAgentDialogWidth
 
@@ -231,38 +288,38 @@ func (a *agentDialog) buildDisplayList(query string) []list.Item {
 }
 
 func (a *agentDialog) buildSearchResults(query string) []list.Item {
-	agentNames := []string{}
-	agentMap := make(map[string]opencode.Agent)
+	type candidate struct {
+		agent   opencode.Agent
+		nameLen int
+	}
+
+	searchStrings := []string{}
+	candidates := []candidate{}
 
 	for _, agent := range a.allAgents {
-		// Search by name
 		searchStr := agent.Name
-		agentNames = append(agentNames, searchStr)
-		agentMap[searchStr] = agent
-
-		// Search by description if available
 		if agent.Description != "" {
 			searchStr = fmt.Sprintf("%s %s", agent.Name, agent.Description)
-			agentNames = append(agentNames, searchStr)
-			agentMap[searchStr] = agent
 		}
+		searchStrings = append(searchStrings, searchStr)
+		candidates = append(candidates, candidate{agent: agent, nameLen: len([]rune(agent.Name))})
 	}
 
-	matches := fuzzy.RankFindFold(query, agentNames)
+	matches := fuzzymatch.Find(query, searchStrings)
 	sort.Sort(matches)
 
 	items := []list.Item{}
-	seenAgents := make(map[string]bool)
-
 	for _, match := range matches {
-		agent := agentMap[match.Target]
-		// Create a unique key to avoid duplicates
-		key := agent.Name
-		if seenAgents[key] {
-			continue
+		c := candidates[match.Index]
+
+		var nameMatches []int
+		for _, pos := range match.Positions {
+			if pos < c.nameLen {
+				nameMatches = append(nameMatches, pos)
+			}
 		}
-		seenAgents[key] = true
-		items = append(items, agentItem{agent: agent})
+
+		items = append(items, agentItem{agent: c.agent, nameMatches: nameMatches})
 	}
 
 	return items
@@ -295,10 +352,13 @@ func NewAgentDialog(app *app.App) AgentDialog {
 	}
 
 	dialog.setupAllAgents()
+	dialog.resize = layout.NewCoalescer(dialog)
 
 	dialog.modal = modal.New(
 		modal.WithTitle("Select Agent"),
 		modal.WithMaxWidth(dialog.dialogWidth+4),
+		modal.WithMaxHeightPercent(maxAgentDialogHeightPercent),
+		modal.WithPlacement(layout.Inline),
 	)
 
 	return dialog
@@ -2,11 +2,16 @@ package dialog
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/v2/textinput"
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/fsnotify/fsnotify"
 	"github.com/muesli/reflow/truncate"
 	"github.com/sst/opencode-sdk-go"
 	"github.com/sst/opencode/internal/app"
@@ -16,6 +21,7 @@ import (
 	"github.com/sst/opencode/internal/styles"
 	"github.com/sst/opencode/internal/theme"
 	"github.com/sst/opencode/internal/util"
+	"github.com/sst/opencode/internal/util/fuzzymatch"
 )
 
 // NavigationDialog interface for the session navigation dialog
@@ -34,6 +40,29 @@ type RestoreToMessageMsg struct {
 	Index     int
 }
 
+// EditMessageMsg is sent when a message should be opened in $EDITOR for
+// correction and resubmission. app.App handles this by writing the
+// message's text parts to a temp file, spawning $EDITOR (falling back to
+// $VISUAL, then vi) via tea.ExecProcess, and — if the file's contents
+// changed on exit — truncating the session at Index and resubmitting the
+// edited text as a new user turn, the same way RestoreToMessageMsg
+// truncates it.
+type EditMessageMsg struct {
+	MessageID string
+	Index     int
+}
+
+// BranchFromMessageMsg is sent when the conversation up to and including a
+// selected message should be cloned into a brand-new session, instead of
+// destructively rewinding the current one. app.App handles this by copying
+// the session up to Index, pointing the clone's ParentSessionID at the
+// current session, and switching the TUI to it.
+type BranchFromMessageMsg struct {
+	MessageID       string
+	Index           int
+	NewSessionTitle string
+}
+
 // navigationItem represents a user message in the navigation list
 type navigationItem struct {
 	messageID string
@@ -41,6 +70,100 @@ type navigationItem struct {
 	timestamp time.Time
 	index     int // Index in the full message list
 	toolCount int // Number of tools used in this message
+
+	// matchPositions holds rune offsets into content's first line that
+	// matched the current search query, for highlighting. Empty when the
+	// query is empty (order then follows sortMode, no highlight).
+	matchPositions []int
+
+	// Per-turn metrics, summed across the assistant messages answering
+	// this user message.
+	promptTokens     int64
+	completionTokens int64
+	elapsed          time.Duration
+	costUSD          float64
+
+	// running is true while the assistant is still working on this turn
+	// (the most recent response has no completion time yet), so Render can
+	// show progress instead of a final elapsed time.
+	running bool
+
+	// elapsedSoFar is how long the still-running assistant message has
+	// been going, as of when this item was built. Only meaningful when
+	// running is true.
+	elapsedSoFar time.Duration
+
+	// baselineElapsed is the average elapsed time across this session's
+	// already-completed turns, used to estimate progress for the turn
+	// that's currently running. Zero when there's no completed turn yet
+	// to compare against.
+	baselineElapsed time.Duration
+}
+
+// formatTokenCount renders n the way the metrics column does: bare below
+// 1000, "1.2k" above it.
+func formatTokenCount(n int64) string {
+	if n < 1000 {
+		return fmt.Sprintf("%d", n)
+	}
+	return fmt.Sprintf("%.1fk", float64(n)/1000)
+}
+
+// metricsLabel renders this item's metrics column, e.g.
+// "1.2k↑ / 3.4k↓ · 8.1s · $0.04". While the turn is still running and
+// has no tokens, time or cost recorded yet, it falls back to "running…"
+// so there's something for Render to show progress over.
+func (n navigationItem) metricsLabel() string {
+	if n.promptTokens == 0 && n.completionTokens == 0 && n.elapsed == 0 && n.costUSD == 0 {
+		if n.running {
+			return "running…"
+		}
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s↑ / %s↓ · %.1fs · $%.2f",
+		formatTokenCount(n.promptTokens),
+		formatTokenCount(n.completionTokens),
+		n.elapsed.Seconds(),
+		n.costUSD,
+	)
+}
+
+// estimateProgress derives a fraction-complete and ETA for a still-running
+// turn by comparing how long it's been running (elapsedSoFar) against the
+// average duration of this session's already-completed turns (baseline).
+// ok is false when there's no baseline yet (e.g. the very first turn of a
+// session), in which case callers should fall back to an indeterminate
+// shimmer instead of a determinate progress bar.
+func estimateProgress(elapsedSoFar, baseline time.Duration) (fraction float64, etaSeconds int, ok bool) {
+	if baseline <= 0 {
+		return 0, 0, false
+	}
+	fraction = elapsedSoFar.Seconds() / baseline.Seconds()
+	remaining := baseline - elapsedSoFar
+	if remaining < 0 {
+		remaining = 0
+	}
+	return fraction, int(remaining.Seconds()), true
+}
+
+// averageElapsed returns the mean elapsed time across items that have
+// already completed (elapsed > 0), used as the baseline a running turn's
+// progress is measured against. Returns 0 when no item has completed yet.
+func averageElapsed(items []navigationItem) time.Duration {
+	var total time.Duration
+	var count int
+	for _, item := range items {
+		if item.running || item.elapsed <= 0 {
+			continue
+		}
+		total += item.elapsed
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / time.Duration(count)
 }
 
 func (n navigationItem) Render(
@@ -52,6 +175,7 @@ func (n navigationItem) Render(
 	t := theme.CurrentTheme()
 	infoStyle := baseStyle.Background(t.BackgroundPanel()).Foreground(t.Info()).Render
 	textStyle := baseStyle.Background(t.BackgroundPanel()).Foreground(t.Text()).Render
+	accentStyle := baseStyle.Background(t.BackgroundPanel()).Foreground(t.Accent()).Bold(true).Render
 
 	// Format timestamp - only apply color when not selected
 	var timeStr string
@@ -64,14 +188,37 @@ func (n navigationItem) Render(
 		timeVisualLen = lipgloss.Width(timeStr)
 	}
 
-	// Tool count display (fixed width for alignment) - only apply color when not selected
+	// Tool count + metrics display (fixed width for alignment) - only
+	// apply color when not selected
+	toolInfoText := ""
+	if n.toolCount > 0 {
+		toolInfoText = fmt.Sprintf("(%d tools)", n.toolCount)
+	}
+	if metrics := n.metricsLabel(); metrics != "" {
+		if toolInfoText != "" {
+			toolInfoText += "  "
+		}
+		toolInfoText += metrics
+	}
+
 	toolInfo := ""
 	toolInfoVisualLen := 0
-	if n.toolCount > 0 {
-		toolInfoText := fmt.Sprintf("(%d tools)", n.toolCount)
-		if selected {
+	if toolInfoText != "" {
+		switch {
+		case selected:
 			toolInfo = toolInfoText
-		} else {
+		case n.running:
+			// While the assistant is still working this turn, show a
+			// determinate progress bar estimated from how this turn's
+			// elapsed time compares to the session's average turn
+			// (falling back to an indeterminate shimmer until there's a
+			// completed turn to baseline against).
+			if fraction, etaSeconds, ok := estimateProgress(n.elapsedSoFar, n.baselineElapsed); ok {
+				toolInfo = util.Progress(toolInfoText, t.BackgroundPanel(), fraction, etaSeconds)
+			} else {
+				toolInfo = util.Shimmer(toolInfoText, t.BackgroundPanel(), t.TextMuted(), t.Accent(), math.NaN())
+			}
+		default:
 			toolInfo = infoStyle(toolInfoText)
 		}
 		toolInfoVisualLen = lipgloss.Width(toolInfo)
@@ -88,12 +235,29 @@ func (n navigationItem) Render(
 		"...",
 	)
 
-	// Apply normal text color to content for non-selected items
+	// Apply normal text color to content for non-selected items, bolding
+	// any runes that matched the current search query.
 	var styledContent string
-	if selected {
-		styledContent = truncatedContent
+	if selected || len(n.matchPositions) == 0 {
+		if selected {
+			styledContent = truncatedContent
+		} else {
+			styledContent = textStyle(truncatedContent)
+		}
 	} else {
-		styledContent = textStyle(truncatedContent)
+		matched := make(map[int]bool, len(n.matchPositions))
+		for _, pos := range n.matchPositions {
+			matched[pos] = true
+		}
+		var b strings.Builder
+		for i, r := range []rune(truncatedContent) {
+			if matched[i] {
+				b.WriteString(accentStyle(string(r)))
+			} else {
+				b.WriteString(textStyle(string(r)))
+			}
+		}
+		styledContent = b.String()
 	}
 
 	// Create the line with proper spacing - content left-aligned, tools right-aligned
@@ -145,10 +309,173 @@ type navigationDialog struct {
 	modal  *modal.Modal
 	list   list.List[navigationItem]
 	app    *app.App
+
+	search        textinput.Model
+	searchFocused bool
+	allItems      []navigationItem // chronological, unfiltered
+
+	// showToolResults expands tool arg summaries in the preview pane,
+	// mirroring the showToolResults flag other TUI chat clients expose.
+	showToolResults bool
+
+	sortMode navigationSortMode
+
+	watcher           *fsnotify.Watcher
+	refreshGeneration int
+}
+
+// refreshDebounceWindow coalesces bursts of filesystem events (a single
+// message write often fires several) into one re-render.
+const refreshDebounceWindow = 150 * time.Millisecond
+
+// navigationRefreshMsg is emitted once refreshDebounceWindow has passed
+// since the last filesystem event, telling Update to re-run the
+// user-message extraction.
+type navigationRefreshMsg struct{ generation int }
+
+type fsEventMsg struct{ event fsnotify.Event }
+type fsErrorMsg struct{ err error }
+
+// navigationSortMode controls the order items are shown in when the
+// search query is empty (a non-empty query always sorts by match score).
+type navigationSortMode int
+
+const (
+	sortChronological navigationSortMode = iota
+	sortLongestLatencyFirst
+	sortHighestCostFirst
+)
+
+func (m navigationSortMode) next() navigationSortMode {
+	return (m + 1) % 3
+}
+
+func (m navigationSortMode) label() string {
+	switch m {
+	case sortLongestLatencyFirst:
+		return "slowest"
+	case sortHighestCostFirst:
+		return "costliest"
+	default:
+		return "chronological"
+	}
 }
 
 func (n *navigationDialog) Init() tea.Cmd {
-	return nil
+	cmds := []tea.Cmd{textinput.Blink}
+
+	if watcher, err := fsnotify.NewWatcher(); err == nil {
+		if err := watcher.Add(sessionMessageDir(n.app)); err == nil {
+			n.watcher = watcher
+			cmds = append(cmds, n.watchCmd())
+		} else {
+			watcher.Close()
+		}
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// sessionMessageDir is the on-disk directory opencode stores this
+// session's message files in, so the navigation dialog can watch it for
+// edits made by another opencode process or a background agent.
+func sessionMessageDir(app *app.App) string {
+	return app.Session.MessageDir()
+}
+
+// watchCmd blocks on the watcher's Events/Errors channels and returns the
+// next one as a message; Update re-issues this after handling it, so the
+// dialog keeps listening for as long as it's open.
+func (n *navigationDialog) watchCmd() tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case event, ok := <-n.watcher.Events:
+			if !ok {
+				return nil
+			}
+			return fsEventMsg{event: event}
+		case err, ok := <-n.watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return fsErrorMsg{err: err}
+		}
+	}
+}
+
+// refreshItems re-runs the user-message extraction against the live
+// app.Messages, preserving the current selection by messageID (list
+// indices shift as messages are added/edited) before re-applying the
+// active search filter.
+func (n *navigationDialog) refreshItems() {
+	var selectedID string
+	if item, idx := n.list.GetSelectedItem(); idx >= 0 {
+		selectedID = item.messageID
+	}
+
+	n.allItems = buildNavigationItems(n.app)
+	n.applyFilter()
+
+	if selectedID == "" {
+		return
+	}
+	for idx, item := range n.list.Items() {
+		if item.messageID == selectedID {
+			n.list.Select(idx)
+			break
+		}
+	}
+}
+
+// applyFilter re-scores allItems against the search query and updates the
+// list, falling back to sortMode's order (chronological by default) when
+// the query is empty.
+func (n *navigationDialog) applyFilter() {
+	query := n.search.Value()
+	if query == "" {
+		items := make([]navigationItem, len(n.allItems))
+		copy(items, n.allItems)
+		for i := range items {
+			items[i].matchPositions = nil
+		}
+		switch n.sortMode {
+		case sortLongestLatencyFirst:
+			sort.SliceStable(items, func(i, j int) bool { return items[i].elapsed > items[j].elapsed })
+		case sortHighestCostFirst:
+			sort.SliceStable(items, func(i, j int) bool { return items[i].costUSD > items[j].costUSD })
+		}
+		n.list.SetItems(items)
+		return
+	}
+
+	type scored struct {
+		item  navigationItem
+		score int
+	}
+
+	var matches []scored
+	for _, item := range n.allItems {
+		firstLine := strings.Split(item.content, "\n")[0]
+		m, ok := fuzzymatch.Match(query, firstLine)
+		if !ok {
+			continue
+		}
+		item.matchPositions = m.Positions
+		matches = append(matches, scored{item: item, score: m.Score})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].item.timestamp.After(matches[j].item.timestamp)
+	})
+
+	items := make([]navigationItem, len(matches))
+	for i, m := range matches {
+		items[i] = m.item
+	}
+	n.list.SetItems(items)
 }
 
 func (n *navigationDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -157,10 +484,29 @@ func (n *navigationDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		n.width = msg.Width
 		n.height = msg.Height
 		n.list.SetMaxWidth(layout.Current.Container.Width - 12)
+	case fsEventMsg:
+		n.refreshGeneration++
+		gen := n.refreshGeneration
+		return n, tea.Batch(
+			n.watchCmd(),
+			tea.Tick(refreshDebounceWindow, func(time.Time) tea.Msg {
+				return navigationRefreshMsg{generation: gen}
+			}),
+		)
+	case fsErrorMsg:
+		return n, n.watchCmd()
+	case navigationRefreshMsg:
+		if msg.generation != n.refreshGeneration {
+			return n, nil
+		}
+		n.refreshItems()
+		return n, nil
 	case tea.KeyPressMsg:
-		switch msg.String() {
-		case "up", "down":
-			// Handle navigation and immediately scroll to selected message
+		key := msg.String()
+
+		if key == "up" || key == "down" {
+			// Handle navigation and immediately scroll to selected message.
+			// Always live, regardless of which pane has focus.
 			var cmd tea.Cmd
 			listModel, cmd := n.list.Update(msg)
 			n.list = listModel.(list.List[navigationItem])
@@ -173,6 +519,45 @@ func (n *navigationDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				)
 			}
 			return n, cmd
+		}
+
+		if key == "enter" {
+			// Keep Enter functionality for closing the modal, whether the
+			// search box or the list currently has focus.
+			if _, idx := n.list.GetSelectedItem(); idx >= 0 {
+				return n, util.CmdHandler(modal.CloseModalMsg{})
+			}
+			return n, nil
+		}
+
+		// While the search box has focus, every other key is text for the
+		// query (including letters that are also list bindings below, like
+		// "r" in "error" or "retry") except Esc, which returns focus to the
+		// list without closing the dialog.
+		if n.searchFocused {
+			if key == "esc" {
+				n.searchFocused = false
+				n.search.Blur()
+				return n, nil
+			}
+
+			prevQuery := n.search.Value()
+			var cmd tea.Cmd
+			n.search, cmd = n.search.Update(msg)
+			if n.search.Value() != prevQuery {
+				n.applyFilter()
+			}
+			return n, cmd
+		}
+
+		switch key {
+		case "/":
+			// Focus the search box (fzf/vim convention) instead of typing
+			// directly into it, so single-letter list bindings below stay
+			// available whenever the query box is empty and unfocused.
+			n.searchFocused = true
+			n.search.Focus()
+			return n, textinput.Blink
 		case "r":
 			// Restore conversation to selected message
 			if item, idx := n.list.GetSelectedItem(); idx >= 0 {
@@ -181,10 +566,41 @@ func (n *navigationDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					util.CmdHandler(modal.CloseModalMsg{}),
 				)
 			}
-		case "enter":
-			// Keep Enter functionality for closing the modal
-			if _, idx := n.list.GetSelectedItem(); idx >= 0 {
-				return n, util.CmdHandler(modal.CloseModalMsg{})
+		case "e":
+			// Open the selected message in $EDITOR for editing and resubmission
+			if item, idx := n.list.GetSelectedItem(); idx >= 0 {
+				return n, tea.Sequence(
+					util.CmdHandler(EditMessageMsg{MessageID: item.messageID, Index: item.index}),
+					util.CmdHandler(modal.CloseModalMsg{}),
+				)
+			}
+		case "s":
+			// Cycle sort order: chronological -> slowest -> costliest
+			n.sortMode = n.sortMode.next()
+			n.applyFilter()
+			return n, nil
+		case "t":
+			// Toggle whether tool args are expanded in the preview pane
+			n.showToolResults = !n.showToolResults
+			return n, nil
+		case "y":
+			// Copy the selected user message text to the clipboard
+			if item, idx := n.list.GetSelectedItem(); idx >= 0 {
+				_ = clipboard.WriteAll(item.content)
+			}
+			return n, nil
+		case "b":
+			// Branch the conversation into a new session instead of
+			// rewinding the current one
+			if item, idx := n.list.GetSelectedItem(); idx >= 0 {
+				return n, tea.Sequence(
+					util.CmdHandler(BranchFromMessageMsg{
+						MessageID:       item.messageID,
+						Index:           item.index,
+						NewSessionTitle: branchTitle(item.content),
+					}),
+					util.CmdHandler(modal.CloseModalMsg{}),
+				)
 			}
 		}
 	}
@@ -196,9 +612,38 @@ func (n *navigationDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (n *navigationDialog) Render(background string) string {
-	listView := n.list.View()
-
 	t := theme.CurrentTheme()
+	searchView := styles.NewStyle().
+		Background(t.BackgroundPanel()).
+		Width(layout.Current.Container.Width - 14).
+		PaddingLeft(1).
+		PaddingBottom(1).
+		Render(n.search.View())
+
+	listAndPreview := layout.Render(
+		layout.FlexOptions{
+			Direction: layout.Row,
+			Width:     layout.Current.Container.Width - 14,
+		},
+		layout.FlexItem{
+			View:  n.list.View(),
+			Width: (layout.Current.Container.Width - 14) * 2 / 5,
+		},
+		layout.FlexItem{
+			View:  n.renderPreview(),
+			Width: (layout.Current.Container.Width - 14) * 3 / 5,
+		},
+	)
+
+	totalsView := styles.NewStyle().
+		Background(t.BackgroundPanel()).
+		Foreground(t.TextMuted()).
+		Width(layout.Current.Container.Width - 14).
+		PaddingLeft(1).
+		Render(n.sessionTotalsLabel())
+
+	listView := searchView + "\n" + totalsView + "\n" + listAndPreview
+
 	keyStyle := styles.NewStyle().
 		Foreground(t.Text()).
 		Background(t.BackgroundPanel()).
@@ -210,10 +655,34 @@ func (n *navigationDialog) Render(background string) string {
 		"↑/↓",
 	) + mutedStyle(
 		" jump   ",
+	) + keyStyle(
+		"/",
+	) + mutedStyle(
+		" search   ",
 	) + keyStyle(
 		"r",
 	) + mutedStyle(
-		" restore",
+		" restore   ",
+	) + keyStyle(
+		"b",
+	) + mutedStyle(
+		" branch   ",
+	) + keyStyle(
+		"e",
+	) + mutedStyle(
+		" edit   ",
+	) + keyStyle(
+		"t",
+	) + mutedStyle(
+		" tools   ",
+	) + keyStyle(
+		"y",
+	) + mutedStyle(
+		" copy   ",
+	) + keyStyle(
+		"s",
+	) + mutedStyle(
+		fmt.Sprintf(" sort (%s)", n.sortMode.label()),
 	)
 
 	bgColor := t.BackgroundPanel()
@@ -230,9 +699,65 @@ func (n *navigationDialog) Render(background string) string {
 }
 
 func (n *navigationDialog) Close() tea.Cmd {
+	if n.watcher != nil {
+		n.watcher.Close()
+	}
 	return nil
 }
 
+// sessionTotalsLabel summarizes tokens/time/cost across every user turn in
+// the session, shown as a header row above the list.
+func (n *navigationDialog) sessionTotalsLabel() string {
+	var promptTokens, completionTokens int64
+	var elapsed time.Duration
+	var costUSD float64
+
+	for _, item := range n.allItems {
+		promptTokens += item.promptTokens
+		completionTokens += item.completionTokens
+		elapsed += item.elapsed
+		costUSD += item.costUSD
+	}
+
+	return fmt.Sprintf(
+		"Session totals: %s↑ / %s↓ · %.1fs · $%.2f",
+		formatTokenCount(promptTokens),
+		formatTokenCount(completionTokens),
+		elapsed.Seconds(),
+		costUSD,
+	)
+}
+
+// renderPreview builds the right-hand pane: the full selected user message
+// followed by a condensed view of the assistant response to it.
+func (n *navigationDialog) renderPreview() string {
+	t := theme.CurrentTheme()
+	headingStyle := styles.NewStyle().Foreground(t.TextMuted()).Background(t.BackgroundPanel()).Bold(true).Render
+	bodyStyle := styles.NewStyle().Foreground(t.Text()).Background(t.BackgroundPanel()).Render
+
+	item, idx := n.list.GetSelectedItem()
+	if idx < 0 {
+		return bodyStyle("No message selected")
+	}
+
+	summary := renderResponseSummary(n.app.Messages, item.index, n.showToolResults)
+
+	return strings.Join([]string{
+		headingStyle("Message"),
+		bodyStyle(item.content),
+		"",
+		headingStyle("Response"),
+		bodyStyle(summary),
+	}, "\n")
+}
+
+// branchTitle derives a default title for a branched session from the
+// first line of the message it forks from.
+func branchTitle(content string) string {
+	firstLine := strings.Split(content, "\n")[0]
+	return truncate.StringWithTail(firstLine, 50, "...")
+}
+
 // extractMessagePreview extracts a preview from message parts
 func extractMessagePreview(parts []opencode.PartUnion) string {
 	for _, part := range parts {
@@ -268,26 +793,145 @@ func countToolsInResponse(messages []app.Message, userMessageIndex int) int {
 	return count
 }
 
-// NewNavigationDialog creates a new session navigation dialog
-func NewNavigationDialog(app *app.App) NavigationDialog {
+const (
+	condensedResponseLines = 3
+	expandedResponseLines  = 20
+	// toolArgsPreviewWidth bounds how much of a tool's raw input gets
+	// dumped into a single summary line, so one oversized argument (e.g. a
+	// large file-write payload) can't blow past expandedResponseLines on
+	// its own.
+	toolArgsPreviewWidth = 80
+)
+
+// turnMetrics sums token, timing, and cost fields across the assistant
+// messages that answered messages[userIndex], using the same
+// "walk forward until the next user message" loop as countToolsInResponse.
+// running is true when the most recent assistant message in the turn
+// hasn't finished yet, so elapsed only reflects completed messages; in that
+// case elapsedSoFar reports how long that still-running message has been
+// going, measured against now.
+func turnMetrics(messages []app.Message, userIndex int, now time.Time) (promptTokens, completionTokens int64, elapsed time.Duration, costUSD float64, running bool, elapsedSoFar time.Duration) {
+	for i := userIndex + 1; i < len(messages); i++ {
+		message := messages[i]
+		if _, isUser := message.Info.(opencode.UserMessage); isUser {
+			break
+		}
+
+		assistant, ok := message.Info.(opencode.AssistantMessage)
+		if !ok {
+			continue
+		}
+
+		promptTokens += int64(assistant.Tokens.Input)
+		completionTokens += int64(assistant.Tokens.Output)
+		costUSD += assistant.Cost
+		if assistant.Time.Completed > 0 {
+			elapsed += time.Duration(assistant.Time.Completed-assistant.Time.Created) * time.Millisecond
+			running = false
+		} else {
+			running = true
+			elapsedSoFar = now.Sub(time.UnixMilli(int64(assistant.Time.Created)))
+		}
+	}
+	return
+}
+
+// renderResponseSummary condenses the assistant response that followed
+// messages[userIndex] into tool names, short arg summaries, and the first
+// N lines of text output — generalizing the extractMessagePreview /
+// countToolsInResponse loop for use in the split-pane preview. expandTools
+// controls whether tool args (truncated to toolArgsPreviewWidth) are
+// appended to each tool's line or omitted for brevity, and raises the line
+// cap from condensedResponseLines to expandedResponseLines.
+func renderResponseSummary(messages []app.Message, userIndex int, expandTools bool) string {
+	maxLines := condensedResponseLines
+	if expandTools {
+		maxLines = expandedResponseLines
+	}
+
+	var lines []string
+	for i := userIndex + 1; i < len(messages); i++ {
+		message := messages[i]
+		if _, isUser := message.Info.(opencode.UserMessage); isUser {
+			break
+		}
+
+		for _, part := range message.Parts {
+			switch casted := part.(type) {
+			case opencode.ToolPart:
+				line := fmt.Sprintf("• %s", casted.Tool)
+				if expandTools && casted.State.Input != nil {
+					args := truncate.StringWithTail(
+						fmt.Sprintf("%v", casted.State.Input),
+						toolArgsPreviewWidth,
+						"...",
+					)
+					line = fmt.Sprintf("%s(%s)", line, args)
+				}
+				lines = append(lines, line)
+			case opencode.TextPart:
+				text := strings.TrimSpace(casted.Text)
+				if text == "" {
+					continue
+				}
+				lines = append(lines, strings.Split(text, "\n")...)
+			}
+		}
+	}
+
+	if len(lines) == 0 {
+		return "No response yet"
+	}
+
+	if len(lines) > maxLines {
+		lines = append(lines[:maxLines], fmt.Sprintf("… %d more lines", len(lines)-maxLines))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// buildNavigationItems filters app.Messages down to user messages and
+// extracts the info navigationItem needs. Shared by the initial
+// construction and by refreshItems, which re-runs this against live
+// messages when the filesystem watcher detects a change.
+func buildNavigationItems(app *app.App) []navigationItem {
 	var items []navigationItem
+	now := time.Now()
 
-	// Filter to only user messages and extract relevant info
 	for i, message := range app.Messages {
 		if userMsg, ok := message.Info.(opencode.UserMessage); ok {
 			preview := extractMessagePreview(message.Parts)
 			toolCount := countToolsInResponse(app.Messages, i)
+			promptTokens, completionTokens, elapsed, costUSD, running, elapsedSoFar := turnMetrics(app.Messages, i, now)
 
 			items = append(items, navigationItem{
-				messageID: userMsg.ID,
-				content:   preview,
-				timestamp: time.UnixMilli(int64(userMsg.Time.Created)),
-				index:     i,
-				toolCount: toolCount,
+				messageID:        userMsg.ID,
+				content:          preview,
+				timestamp:        time.UnixMilli(int64(userMsg.Time.Created)),
+				index:            i,
+				toolCount:        toolCount,
+				promptTokens:     promptTokens,
+				completionTokens: completionTokens,
+				elapsed:          elapsed,
+				costUSD:          costUSD,
+				running:          running,
+				elapsedSoFar:     elapsedSoFar,
 			})
 		}
 	}
 
+	baseline := averageElapsed(items)
+	for i := range items {
+		items[i].baselineElapsed = baseline
+	}
+
+	return items
+}
+
+// NewNavigationDialog creates a new session navigation dialog
+func NewNavigationDialog(app *app.App) NavigationDialog {
+	items := buildNavigationItems(app)
+
 	listComponent := list.NewListComponent(
 		list.WithItems(items),
 		list.WithMaxVisibleHeight[navigationItem](12),
@@ -304,12 +948,27 @@ func NewNavigationDialog(app *app.App) NavigationDialog {
 	)
 	listComponent.SetMaxWidth(layout.Current.Container.Width - 12)
 
+	search := textinput.New()
+	search.Placeholder = "Filter messages... (/ to search)"
+
 	return &navigationDialog{
-		list: listComponent,
-		app:  app,
+		list:     listComponent,
+		app:      app,
+		allItems: items,
+		search:   search,
 		modal: modal.New(
-			modal.WithTitle("Jump to Message"),
+			modal.WithTitle(navigationTitle(app)),
 			modal.WithMaxWidth(layout.Current.Container.Width-8),
 		),
 	}
 }
+
+// navigationTitle is the modal title, with a breadcrumb naming the parent
+// session when the current session is itself a branch, so users can tell
+// they're navigating one conversation in a tree of forks.
+func navigationTitle(app *app.App) string {
+	if app.Session.ParentSessionID == "" {
+		return "Jump to Message"
+	}
+	return fmt.Sprintf("Jump to Message — forked from %s", app.ParentSessionTitle())
+}
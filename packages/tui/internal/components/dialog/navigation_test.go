@@ -0,0 +1,200 @@
+package dialog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sst/opencode-sdk-go"
+	"github.com/sst/opencode/internal/app"
+)
+
+func TestFormatTokenCount_BelowThousand(t *testing.T) {
+	if got := formatTokenCount(42); got != "42" {
+		t.Fatalf("expected bare count below 1000, got %q", got)
+	}
+}
+
+func TestFormatTokenCount_AboveThousand(t *testing.T) {
+	if got := formatTokenCount(1234); got != "1.2k" {
+		t.Fatalf("expected 1.2k above 1000, got %q", got)
+	}
+}
+
+func TestMetricsLabel_RunningWithNoMetricsYet(t *testing.T) {
+	item := navigationItem{running: true}
+	if got := item.metricsLabel(); got != "running…" {
+		t.Fatalf("expected running ellipsis placeholder, got %q", got)
+	}
+}
+
+func TestMetricsLabel_EmptyWhenNoMetricsAndNotRunning(t *testing.T) {
+	item := navigationItem{}
+	if got := item.metricsLabel(); got != "" {
+		t.Fatalf("expected empty label, got %q", got)
+	}
+}
+
+func TestMetricsLabel_FormatsCompletedTurn(t *testing.T) {
+	item := navigationItem{
+		promptTokens:     1500,
+		completionTokens: 250,
+		elapsed:          8100 * time.Millisecond,
+		costUSD:          0.04,
+	}
+	want := "1.5k↑ / 250↓ · 8.1s · $0.04"
+	if got := item.metricsLabel(); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestNavigationSortMode_NextCycles(t *testing.T) {
+	m := sortChronological
+	m = m.next()
+	if m != sortLongestLatencyFirst {
+		t.Fatalf("expected sortLongestLatencyFirst after chronological, got %v", m)
+	}
+	m = m.next()
+	if m != sortHighestCostFirst {
+		t.Fatalf("expected sortHighestCostFirst after latency, got %v", m)
+	}
+	m = m.next()
+	if m != sortChronological {
+		t.Fatalf("expected cycle back to chronological, got %v", m)
+	}
+}
+
+func TestNavigationSortMode_Label(t *testing.T) {
+	cases := map[navigationSortMode]string{
+		sortChronological:       "chronological",
+		sortLongestLatencyFirst: "slowest",
+		sortHighestCostFirst:    "costliest",
+	}
+	for mode, want := range cases {
+		if got := mode.label(); got != want {
+			t.Fatalf("expected label %q for mode %v, got %q", want, mode, got)
+		}
+	}
+}
+
+func TestBranchTitle_UsesFirstLineTruncated(t *testing.T) {
+	got := branchTitle("short title\nsecond line")
+	if got != "short title" {
+		t.Fatalf("expected first line only, got %q", got)
+	}
+}
+
+func TestBranchTitle_TruncatesLongFirstLine(t *testing.T) {
+	long := "this is a very long first line that definitely exceeds the fifty rune cap we truncate to"
+	got := branchTitle(long)
+	if got == long {
+		t.Fatalf("expected truncation of a long first line")
+	}
+	if got[len(got)-3:] != "..." {
+		t.Fatalf("expected truncated title to end with ellipsis, got %q", got)
+	}
+}
+
+func assistantMessage(createdMs, completedMs float64, input, output float64, cost float64) app.Message {
+	return app.Message{
+		Info: opencode.AssistantMessage{
+			Tokens: opencode.AssistantMessageTokens{Input: input, Output: output},
+			Cost:   cost,
+			Time:   opencode.AssistantMessageTime{Created: createdMs, Completed: completedMs},
+		},
+	}
+}
+
+func userMessage() app.Message {
+	return app.Message{Info: opencode.UserMessage{}}
+}
+
+func TestTurnMetrics_SumsCompletedMessages(t *testing.T) {
+	messages := []app.Message{
+		userMessage(),
+		assistantMessage(1000, 3000, 100, 200, 0.01),
+		assistantMessage(3000, 9000, 50, 75, 0.02),
+		userMessage(),
+	}
+
+	promptTokens, completionTokens, elapsed, costUSD, running, elapsedSoFar := turnMetrics(messages, 0, time.UnixMilli(9000))
+
+	if promptTokens != 150 || completionTokens != 275 {
+		t.Fatalf("expected tokens 150/275, got %d/%d", promptTokens, completionTokens)
+	}
+	if elapsed != 8*time.Second {
+		t.Fatalf("expected 8s elapsed, got %v", elapsed)
+	}
+	if costUSD != 0.03 {
+		t.Fatalf("expected cost 0.03, got %v", costUSD)
+	}
+	if running {
+		t.Fatalf("expected running=false once the turn's messages all completed")
+	}
+	if elapsedSoFar != 0 {
+		t.Fatalf("expected elapsedSoFar=0 for a completed turn, got %v", elapsedSoFar)
+	}
+}
+
+func TestTurnMetrics_ReportsElapsedSoFarWhileRunning(t *testing.T) {
+	messages := []app.Message{
+		userMessage(),
+		assistantMessage(1000, 0, 10, 0, 0),
+	}
+
+	now := time.UnixMilli(1000).Add(5 * time.Second)
+	_, _, _, _, running, elapsedSoFar := turnMetrics(messages, 0, now)
+
+	if !running {
+		t.Fatalf("expected running=true for an in-flight assistant message")
+	}
+	if elapsedSoFar != 5*time.Second {
+		t.Fatalf("expected elapsedSoFar=5s, got %v", elapsedSoFar)
+	}
+}
+
+func TestAverageElapsed_IgnoresRunningAndZeroItems(t *testing.T) {
+	items := []navigationItem{
+		{elapsed: 10 * time.Second},
+		{elapsed: 20 * time.Second},
+		{running: true, elapsed: 0},
+	}
+	if got := averageElapsed(items); got != 15*time.Second {
+		t.Fatalf("expected average of completed turns only (15s), got %v", got)
+	}
+}
+
+func TestAverageElapsed_ZeroWhenNothingCompleted(t *testing.T) {
+	items := []navigationItem{{running: true}}
+	if got := averageElapsed(items); got != 0 {
+		t.Fatalf("expected 0 baseline when no turn has completed, got %v", got)
+	}
+}
+
+func TestEstimateProgress_NoBaselineIsNotOk(t *testing.T) {
+	if _, _, ok := estimateProgress(5*time.Second, 0); ok {
+		t.Fatalf("expected ok=false without a baseline")
+	}
+}
+
+func TestEstimateProgress_ComputesFractionAndEta(t *testing.T) {
+	fraction, etaSeconds, ok := estimateProgress(5*time.Second, 10*time.Second)
+	if !ok {
+		t.Fatalf("expected ok=true with a baseline")
+	}
+	if fraction != 0.5 {
+		t.Fatalf("expected fraction 0.5, got %v", fraction)
+	}
+	if etaSeconds != 5 {
+		t.Fatalf("expected eta 5s, got %d", etaSeconds)
+	}
+}
+
+func TestEstimateProgress_ClampsEtaWhenOverBaseline(t *testing.T) {
+	_, etaSeconds, ok := estimateProgress(20*time.Second, 10*time.Second)
+	if !ok {
+		t.Fatalf("expected ok=true with a baseline")
+	}
+	if etaSeconds != 0 {
+		t.Fatalf("expected eta to clamp to 0 once past the baseline, got %d", etaSeconds)
+	}
+}
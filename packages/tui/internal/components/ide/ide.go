@@ -1,7 +1,10 @@
 package ide
 
 import (
+	"embed"
+	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea/v2"
@@ -11,6 +14,115 @@ import (
 	"github.com/sst/opencode/internal/theme"
 )
 
+//go:embed hosts/*.json
+var hostManifests embed.FS
+
+// Host identifies the editor/IDE the TUI is running inside, so the
+// shortcut table can show bindings that actually work there.
+type Host string
+
+const (
+	HostVSCode    Host = "vscode"
+	HostJetBrains Host = "jetbrains"
+	HostNeovim    Host = "neovim"
+	HostHelix     Host = "helix"
+	HostZed       Host = "zed"
+	HostEmacs     Host = "emacs"
+	HostUnknown   Host = "unknown"
+)
+
+// shortcut is one row of the rendered table.
+type shortcut struct {
+	Shortcut    string `json:"shortcut"`
+	Description string `json:"description"`
+}
+
+// Shortcut is the exported name of a table row, so IDE plugins outside
+// this package can build rows to pass to RegisterShortcuts.
+type Shortcut = shortcut
+
+// section is a named group of shortcuts, e.g. "Open", "Insert", "Diff".
+type section struct {
+	Title     string     `json:"title"`
+	Shortcuts []shortcut `json:"shortcuts"`
+}
+
+// manifest mirrors the shape of a hosts/*.json file.
+type manifest struct {
+	Host     Host      `json:"host"`
+	Sections []section `json:"sections"`
+}
+
+// registry holds the built-in per-host sections loaded from hosts/*.json,
+// plus any rows IDE plugins register at init time via RegisterShortcuts.
+var registry = loadBuiltinRegistry()
+
+func loadBuiltinRegistry() map[Host][]section {
+	reg := map[Host][]section{}
+
+	entries, err := hostManifests.ReadDir("hosts")
+	if err != nil {
+		return reg
+	}
+	for _, entry := range entries {
+		data, err := hostManifests.ReadFile("hosts/" + entry.Name())
+		if err != nil {
+			continue
+		}
+		var m manifest
+		if err := json.Unmarshal(data, &m); err != nil {
+			continue
+		}
+		reg[m.Host] = m.Sections
+	}
+	return reg
+}
+
+// RegisterShortcuts appends rows to host's section titled title, creating
+// the section if it doesn't exist yet. IDE plugins call this at init time
+// so new integrations only need to ship their rows, not a fork of this
+// file.
+func RegisterShortcuts(host Host, title string, rows ...shortcut) {
+	sections := registry[host]
+	for i := range sections {
+		if sections[i].Title == title {
+			sections[i].Shortcuts = append(sections[i].Shortcuts, rows...)
+			registry[host] = sections
+			return
+		}
+	}
+	registry[host] = append(sections, section{Title: title, Shortcuts: rows})
+}
+
+// DetectHost infers the host editor from environment variables set by
+// common terminal/IDE integrations.
+func DetectHost() Host {
+	if _, ok := os.LookupEnv("ZED_TERM"); ok {
+		return HostZed
+	}
+	if _, ok := os.LookupEnv("INSIDE_EMACS"); ok {
+		return HostEmacs
+	}
+	if _, ok := os.LookupEnv("NVIM"); ok {
+		return HostNeovim
+	}
+	if _, ok := os.LookupEnv("VIM"); ok {
+		return HostNeovim
+	}
+	if strings.Contains(strings.ToLower(os.Getenv("TERMINAL_EMULATOR")), "jetbrains") {
+		return HostJetBrains
+	}
+	if term := os.Getenv("TERM_PROGRAM"); term != "" {
+		switch strings.ToLower(term) {
+		case "vscode":
+			return HostVSCode
+		case "zed":
+			return HostZed
+		}
+	}
+	return HostUnknown
+}
+
 type IdeComponent interface {
 	tea.ViewModel
 	SetSize(width, height int) tea.Cmd
@@ -20,6 +132,7 @@ type IdeComponent interface {
 type ideComponent struct {
 	width, height int
 	background    *compat.AdaptiveColor
+	host          Host
 }
 
 func (c *ideComponent) SetSize(width, height int) tea.Cmd {
@@ -37,56 +150,56 @@ func (c *ideComponent) View() string {
 
 	triggerStyle := styles.NewStyle().Foreground(t.Primary()).Bold(true)
 	descriptionStyle := styles.NewStyle().Foreground(t.Text())
+	headingStyle := styles.NewStyle().Foreground(t.TextMuted())
 
 	if c.background != nil {
 		triggerStyle = triggerStyle.Background(*c.background)
 		descriptionStyle = descriptionStyle.Background(*c.background)
+		headingStyle = headingStyle.Background(*c.background)
 	}
 
-	// VSCode shortcuts data
-	shortcuts := []struct {
-		shortcut    string
-		description string
-	}{
-		{"Cmd+Esc", "open opencode in VS Code"},
-		{"Cmd+Opt+K", "insert file from VS Code"},
+	sections := registry[c.host]
+	if len(sections) == 0 {
+		sections = registry[HostVSCode]
 	}
 
-	// Calculate column widths
 	maxShortcutWidth := 0
 	maxDescriptionWidth := 0
-
-	for _, shortcut := range shortcuts {
-		if len(shortcut.shortcut) > maxShortcutWidth {
-			maxShortcutWidth = len(shortcut.shortcut)
-		}
-		if len(shortcut.description) > maxDescriptionWidth {
-			maxDescriptionWidth = len(shortcut.description)
+	for _, s := range sections {
+		for _, row := range s.Shortcuts {
+			maxShortcutWidth = max(maxShortcutWidth, len(row.Shortcut))
+			maxDescriptionWidth = max(maxDescriptionWidth, len(row.Description))
 		}
 	}
 
-	// Add padding between columns
 	columnPadding := 3
 
-	// Build the output
 	var output strings.Builder
-
 	maxWidth := 0
-	for _, shortcut := range shortcuts {
-		// Pad each column to align properly
-		shortcutText := fmt.Sprintf("%-*s", maxShortcutWidth, shortcut.shortcut)
-		description := fmt.Sprintf("%-*s", maxDescriptionWidth, shortcut.description)
+	multiSection := len(sections) > 1
 
-		// Apply styles and combine
-		line := triggerStyle.Render(shortcutText) +
-			triggerStyle.Render(strings.Repeat(" ", columnPadding)) +
-			descriptionStyle.Render(description)
+	for i, s := range sections {
+		if multiSection {
+			heading := headingStyle.Render(s.Title)
+			output.WriteString(heading + "\n")
+			maxWidth = max(maxWidth, lipgloss.Width(heading))
+		}
+		for _, row := range s.Shortcuts {
+			shortcutText := fmt.Sprintf("%-*s", maxShortcutWidth, row.Shortcut)
+			description := fmt.Sprintf("%-*s", maxDescriptionWidth, row.Description)
 
-		output.WriteString(line + "\n")
-		maxWidth = max(maxWidth, lipgloss.Width(line))
+			line := triggerStyle.Render(shortcutText) +
+				triggerStyle.Render(strings.Repeat(" ", columnPadding)) +
+				descriptionStyle.Render(description)
+
+			output.WriteString(line + "\n")
+			maxWidth = max(maxWidth, lipgloss.Width(line))
+		}
+		if multiSection && i < len(sections)-1 {
+			output.WriteString("\n")
+		}
 	}
 
-	// Remove trailing newline
 	result := strings.TrimSuffix(output.String(), "\n")
 	if c.background != nil {
 		result = styles.NewStyle().Background(*c.background).Width(maxWidth).Render(result)
@@ -103,8 +216,16 @@ func WithBackground(background compat.AdaptiveColor) Option {
 	}
 }
 
+// WithHost overrides host auto-detection, e.g. for tests or a plugin that
+// knows better than the environment variables do.
+func WithHost(host Host) Option {
+	return func(c *ideComponent) {
+		c.host = host
+	}
+}
+
 func New(opts ...Option) IdeComponent {
-	c := &ideComponent{}
+	c := &ideComponent{host: DetectHost()}
 	for _, opt := range opts {
 		opt(c)
 	}
@@ -0,0 +1,94 @@
+// Package modal provides the titled overlay box every dialog (agent
+// picker, navigation, search, ...) renders its content inside.
+package modal
+
+import (
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/sst/opencode/internal/layout"
+)
+
+// CloseModalMsg closes whatever modal is currently on top of the app's
+// modal stack.
+type CloseModalMsg struct{}
+
+// Modal renders a titled box around arbitrary content: centered
+// full-screen by default, or anchored above the editor and sized to its
+// content with WithPlacement(layout.Inline).
+type Modal struct {
+	title            string
+	maxWidth         int
+	maxHeightPercent int
+	placement        layout.Placement
+}
+
+// Option configures a Modal built with New.
+type Option func(*Modal)
+
+// WithTitle sets the box's title.
+func WithTitle(title string) Option {
+	return func(m *Modal) { m.title = title }
+}
+
+// WithMaxWidth caps the box's rendered width.
+func WithMaxWidth(width int) Option {
+	return func(m *Modal) { m.maxWidth = width }
+}
+
+// WithMaxHeightPercent caps the modal to at most percent of the terminal
+// height (fzf's "--height N%" style) instead of always sizing off its
+// content or filling the screen. Only meaningful with
+// WithPlacement(layout.Inline).
+func WithMaxHeightPercent(percent int) Option {
+	return func(m *Modal) { m.maxHeightPercent = percent }
+}
+
+// WithPlacement sets where the modal renders. Defaults to
+// layout.Centered.
+func WithPlacement(placement layout.Placement) Option {
+	return func(m *Modal) { m.placement = placement }
+}
+
+// New builds a Modal from opts.
+func New(opts ...Option) *Modal {
+	m := &Modal{maxWidth: 80}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Render draws content inside a bordered, titled box and, for the
+// default Centered placement, places that box over background. With
+// WithPlacement(layout.Inline), the box is returned on its own, clipped
+// to maxHeightPercent of the terminal height when set, for the caller to
+// anchor inline instead of centering over the full screen.
+func (m *Modal) Render(content, background string) string {
+	width := m.maxWidth
+	if width <= 0 {
+		width = lipgloss.Width(content) + 4
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		Padding(0, 1).
+		Width(width).
+		Render(m.title + "\n\n" + content)
+
+	if m.placement == layout.Inline {
+		if m.maxHeightPercent > 0 {
+			maxHeight := layout.Current.Container.Height * m.maxHeightPercent / 100
+			if maxHeight > 0 && lipgloss.Height(box) > maxHeight {
+				box = lipgloss.NewStyle().MaxHeight(maxHeight).Render(box)
+			}
+		}
+		return box
+	}
+
+	return lipgloss.Place(
+		layout.Current.Container.Width,
+		layout.Current.Container.Height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
@@ -0,0 +1,129 @@
+package layout
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/compat"
+)
+
+// Modal is implemented by any full-screen or inline overlay dialog hosted
+// by the app's modal stack (agent/navigation/search dialogs, etc).
+type Modal interface {
+	tea.Model
+	Render(background string) string
+	Close() tea.Cmd
+}
+
+// Direction is the axis a Flex layout arranges its FlexItems along.
+type Direction int
+
+const (
+	Row Direction = iota
+	Column
+)
+
+// Justify controls how extra space along Direction is distributed among
+// FlexItems.
+type Justify int
+
+const (
+	JustifyStart Justify = iota
+	JustifyCenter
+	JustifySpaceBetween
+)
+
+// Align controls how FlexItems are sized across the cross axis.
+type Align int
+
+const (
+	AlignStart Align = iota
+	AlignStretch
+)
+
+// Placement controls where a Modal renders relative to the terminal.
+type Placement int
+
+const (
+	// Centered overlays the modal in the middle of the full terminal area.
+	// This is the default for dialogs that don't set WithPlacement.
+	Centered Placement = iota
+	// Inline anchors the modal above the editor, sized to its content
+	// instead of the full screen, fzf's "--height" style.
+	Inline
+)
+
+// FlexItem is one cell of a Flex layout.
+type FlexItem struct {
+	View string
+	// Width fixes this item's rendered width; zero means size to content.
+	Width int
+}
+
+// FlexOptions configures a Flex layout rendered by Render.
+type FlexOptions struct {
+	Direction  Direction
+	Justify    Justify
+	Align      Align
+	Width      int
+	Background *compat.AdaptiveColor
+}
+
+// Container describes the size of the area the TUI is currently laid out
+// in, kept up to date by the root Update loop on tea.WindowSizeMsg.
+type Container struct {
+	Width  int
+	Height int
+}
+
+// Current holds the live container size and exposes it to components
+// that size themselves off the terminal dimensions instead of threading
+// width/height through every constructor.
+var Current = struct{ Container Container }{Container: Container{Width: 80, Height: 24}}
+
+// Render lays out items along opts.Direction, applying opts.Background.
+// Row with exactly two items and JustifySpaceBetween pins the first item
+// left and the second right, padded to opts.Width — the common
+// "label ... trailing info" row used across list items and dialog headers.
+// Any other Justify value lays items out from the start, and opts.Align is
+// not read.
+func Render(opts FlexOptions, items ...FlexItem) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	background := func(s string) string {
+		if opts.Background == nil {
+			return s
+		}
+		return lipgloss.NewStyle().Background(*opts.Background).Render(s)
+	}
+
+	if opts.Direction == Column {
+		lines := make([]string, len(items))
+		for i, item := range items {
+			lines[i] = item.View
+		}
+		return background(strings.Join(lines, "\n"))
+	}
+
+	if opts.Justify == JustifySpaceBetween && len(items) == 2 {
+		left, right := items[0].View, items[1].View
+		gap := opts.Width - lipgloss.Width(left) - lipgloss.Width(right)
+		if gap < 1 {
+			gap = 1
+		}
+		return background(left + strings.Repeat(" ", gap) + right)
+	}
+
+	var b strings.Builder
+	for _, item := range items {
+		view := item.View
+		if item.Width > 0 {
+			view = lipgloss.NewStyle().Width(item.Width).Render(view)
+		}
+		b.WriteString(view)
+	}
+	return background(b.String())
+}
@@ -0,0 +1,86 @@
+// Package layout hosts shared TUI layout primitives, including resize
+// coalescing for the Bubble Tea root loop.
+package layout
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+)
+
+// resizeCoalesceWindow is how long a burst of WindowSizeMsg can keep
+// arriving before Coalescer flushes a single re-layout. 16ms matches one
+// frame at 60fps, which is about how fast a terminal emulator fires resize
+// events while a user is dragging a window edge.
+const resizeCoalesceWindow = 16 * time.Millisecond
+
+// Resizable is implemented by any component that wants to opt into
+// coalesced resize delivery instead of handling every tea.WindowSizeMsg
+// itself. SetSize is called at most once per coalesced burst.
+type Resizable interface {
+	SetSize(width, height int) tea.Cmd
+}
+
+// LayoutDoneMsg is emitted after a coalesced resize has been applied, so
+// components like shimmer/animations can re-anchor against the new size.
+type LayoutDoneMsg struct {
+	Width, Height int
+}
+
+// Coalescer collapses bursts of tea.WindowSizeMsg within
+// resizeCoalesceWindow into a single SetSize call plus one LayoutDoneMsg,
+// even when a modal or prompt currently holds focus. Construct one per
+// root Bubble Tea program and route every incoming tea.WindowSizeMsg
+// through Handle.
+type Coalescer struct {
+	targets    []Resizable
+	pending    *tea.WindowSizeMsg
+	generation int
+}
+
+// NewCoalescer builds a Coalescer that applies each flushed resize to
+// targets, in order.
+func NewCoalescer(targets ...Resizable) *Coalescer {
+	return &Coalescer{targets: targets}
+}
+
+// resizeFlushMsg is an internal tick that tells Handle the coalescing
+// window for a burst has elapsed. generation lets stale ticks from earlier
+// in a burst no-op once a newer WindowSizeMsg has superseded them.
+type resizeFlushMsg struct{ generation int }
+
+// Handle records msg and returns a command that fires once
+// resizeCoalesceWindow has passed without a newer WindowSizeMsg arriving.
+// Callers should forward every tea.WindowSizeMsg here instead of applying
+// it directly, including while a modal has focus.
+func (c *Coalescer) Handle(msg tea.WindowSizeMsg) tea.Cmd {
+	c.pending = &msg
+	c.generation++
+	gen := c.generation
+	return tea.Tick(resizeCoalesceWindow, func(time.Time) tea.Msg {
+		return resizeFlushMsg{generation: gen}
+	})
+}
+
+// Flush applies the most recently pending resize, but only for the tick
+// matching the latest burst (earlier ticks superseded by a newer
+// WindowSizeMsg are ignored), and returns a command emitting LayoutDoneMsg.
+// Call this from the root Update for every message; it's a no-op for
+// anything other than its own resizeFlushMsg.
+func (c *Coalescer) Flush(msg tea.Msg) tea.Cmd {
+	flush, ok := msg.(resizeFlushMsg)
+	if !ok || flush.generation != c.generation || c.pending == nil {
+		return nil
+	}
+
+	size := *c.pending
+	c.pending = nil
+
+	for _, target := range c.targets {
+		target.SetSize(size.Width, size.Height)
+	}
+
+	return func() tea.Msg {
+		return LayoutDoneMsg{Width: size.Width, Height: size.Height}
+	}
+}
@@ -0,0 +1,77 @@
+package layout
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+)
+
+type countingResizable struct {
+	calls int
+}
+
+func (c *countingResizable) SetSize(width, height int) tea.Cmd {
+	c.calls++
+	return nil
+}
+
+func TestCoalescer_FlushIgnoresStaleGeneration(t *testing.T) {
+	target := &countingResizable{}
+	c := NewCoalescer(target)
+
+	cmd1 := c.Handle(tea.WindowSizeMsg{Width: 80, Height: 24})
+	c.Handle(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	// The first tick (stale generation) should not apply anything.
+	if got := c.Flush(cmd1()); got != nil {
+		t.Fatalf("expected stale flush to be a no-op, got a command")
+	}
+	if target.calls != 0 {
+		t.Fatalf("expected 0 SetSize calls from a stale flush, got %d", target.calls)
+	}
+}
+
+func TestCoalescer_FlushAppliesLatestPending(t *testing.T) {
+	target := &countingResizable{}
+	c := NewCoalescer(target)
+
+	c.Handle(tea.WindowSizeMsg{Width: 80, Height: 24})
+	cmd2 := c.Handle(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	if got := c.Flush(cmd2()); got == nil {
+		t.Fatalf("expected the latest flush to emit LayoutDoneMsg")
+	}
+	if target.calls != 1 {
+		t.Fatalf("expected exactly 1 SetSize call, got %d", target.calls)
+	}
+}
+
+// BenchmarkCoalescer_DragResize simulates a drag-resize burst of 100
+// WindowSizeMsg events and shows only the final one reaches SetSize,
+// versus applying every message directly.
+func BenchmarkCoalescer_DragResize(b *testing.B) {
+	const burstSize = 100
+
+	b.Run("uncoalesced", func(b *testing.B) {
+		target := &countingResizable{}
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < burstSize; j++ {
+				target.SetSize(80+j, 24)
+			}
+		}
+		b.ReportMetric(float64(target.calls)/float64(b.N), "setsize-calls/op")
+	})
+
+	b.Run("coalesced", func(b *testing.B) {
+		target := &countingResizable{}
+		c := NewCoalescer(target)
+		for i := 0; i < b.N; i++ {
+			var last tea.Cmd
+			for j := 0; j < burstSize; j++ {
+				last = c.Handle(tea.WindowSizeMsg{Width: 80 + j, Height: 24})
+			}
+			c.Flush(last())
+		}
+		b.ReportMetric(float64(target.calls)/float64(b.N), "setsize-calls/op")
+	})
+}
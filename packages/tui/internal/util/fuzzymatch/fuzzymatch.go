@@ -0,0 +1,134 @@
+// Package fuzzymatch implements a diacritic-insensitive, path-aware fuzzy
+// matcher shared by the agent dialog, completion providers, and the command
+// palette.
+package fuzzymatch
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Match is a scored result against a single target string. Positions are
+// rune offsets into the original (non-normalized) target so callers can
+// bold the matched runes without re-running normalization themselves.
+// Index is the target's position in the slice passed to Find, so callers
+// can recover which target matched even when two targets produced the
+// same string (Target alone can't disambiguate that case).
+type Match struct {
+	Target    string
+	Index     int
+	Score     int
+	Positions []int
+}
+
+type Matches []Match
+
+func (m Matches) Len() int           { return len(m) }
+func (m Matches) Less(i, j int) bool { return m[i].Score > m[j].Score }
+func (m Matches) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+
+var stripDiacritics = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// Normalize strips diacritics (via NFD + removal of non-spacing marks) and
+// lower-cases s, so "Só Danço" and "so danco" compare equal.
+func Normalize(s string) string {
+	out, _, err := transform.String(stripDiacritics, s)
+	if err != nil {
+		out = s
+	}
+	return strings.ToLower(out)
+}
+
+// isBoundary reports whether r is a word-boundary rune: whitespace or one
+// of the path-like separators agent IDs and file paths use.
+func isBoundary(r rune) bool {
+	switch r {
+	case '/', '-', '_', '.', ' ':
+		return true
+	}
+	return unicode.IsSpace(r)
+}
+
+// Match scores query against target using a Smith-Waterman-style subsequence
+// search: every query rune must appear in order in the normalized target,
+// with bonuses for matches at word boundaries, immediately after a
+// separator, or at a camelCase transition, and a small penalty for gaps
+// between consecutive matches. Returns ok=false when query doesn't match as
+// a subsequence at all.
+func Match(query, target string) (Match, bool) {
+	if query == "" {
+		return Match{Target: target, Score: 0}, true
+	}
+
+	nq := []rune(Normalize(query))
+	// Keep the normalized target aligned 1:1 with target's runes so
+	// Positions can index back into the original string; Normalize is
+	// rune-count preserving for the Mn-stripping + lower-casing it does.
+	targetRunes := []rune(target)
+	nt := []rune(Normalize(target))
+	if len(nt) != len(targetRunes) {
+		// Normalization changed rune count (rare combining edge case);
+		// fall back to matching against the normalized copy only, which
+		// still lets us report approximate positions.
+		targetRunes = nt
+	}
+
+	qi := 0
+	score := 0
+	positions := make([]int, 0, len(nq))
+	lastMatch := -1
+
+	for i := 0; i < len(nt) && qi < len(nq); i++ {
+		if nt[i] != nq[qi] {
+			continue
+		}
+
+		bonus := 1
+		switch {
+		case i == 0:
+			bonus += 8
+		case isBoundary(rune(nt[i-1])):
+			bonus += 8
+		case unicode.IsUpper(targetRunes[i]) && i > 0 && unicode.IsLower(targetRunes[i-1]):
+			bonus += 6
+		}
+
+		if lastMatch >= 0 {
+			gap := i - lastMatch - 1
+			if gap == 0 {
+				bonus += 4 // consecutive match
+			} else {
+				score -= min(gap, 3)
+			}
+		}
+
+		score += bonus
+		positions = append(positions, i)
+		lastMatch = i
+		qi++
+	}
+
+	if qi < len(nq) {
+		return Match{}, false
+	}
+
+	return Match{Target: target, Score: score, Positions: positions}, true
+}
+
+// Find scores query against every target and returns the matches, each
+// tagged with its Index into targets, in the order the targets were given
+// (callers typically sort.Sort the result).
+func Find(query string, targets []string) Matches {
+	matches := make(Matches, 0, len(targets))
+	for i, t := range targets {
+		if m, ok := Match(query, t); ok {
+			m.Index = i
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,64 @@
+package fuzzymatch
+
+import "testing"
+
+func TestMatch_AccentedQueryMatchesDiacriticTarget(t *testing.T) {
+	if _, ok := Match("sodanco", "Só Danço"); !ok {
+		t.Fatalf("expected accent-insensitive match")
+	}
+}
+
+func TestMatch_TokenizedPathMatch(t *testing.T) {
+	m, ok := Match("ab", "agents/alpha-beta.yaml")
+	if !ok {
+		t.Fatalf("expected path-aware match")
+	}
+	if len(m.Positions) != 2 {
+		t.Fatalf("expected 2 matched positions, got %d", len(m.Positions))
+	}
+}
+
+func TestMatch_NoSubsequence_NotOk(t *testing.T) {
+	if _, ok := Match("zzz", "alpha"); ok {
+		t.Fatalf("expected no match when query isn't a subsequence")
+	}
+}
+
+func TestMatch_BoundaryBeatsMidword(t *testing.T) {
+	boundary, ok := Match("beta", "alpha-beta")
+	if !ok {
+		t.Fatalf("expected match at boundary")
+	}
+	midword, ok := Match("lpha", "alphabeta")
+	if !ok {
+		t.Fatalf("expected mid-word match")
+	}
+	if boundary.Score <= midword.Score {
+		t.Fatalf("expected boundary match to score higher: boundary=%d midword=%d", boundary.Score, midword.Score)
+	}
+}
+
+func TestFind_ReturnsOnlyMatchingTargets(t *testing.T) {
+	matches := Find("ab", []string{"xyz-ab", "ab-xyz", "zzz"})
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(matches))
+	}
+}
+
+func TestFind_TagsDuplicateTargetsWithDistinctIndex(t *testing.T) {
+	// Two targets with identical text (e.g. two agents that both produce
+	// "reviewer general purpose") must still come back as two matches,
+	// each pointing at its own originating index.
+	matches := Find("rev", []string{"reviewer general purpose", "reviewer general purpose"})
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches for 2 identical targets, got %d", len(matches))
+	}
+
+	seen := map[int]bool{}
+	for _, m := range matches {
+		seen[m.Index] = true
+	}
+	if !seen[0] || !seen[1] {
+		t.Fatalf("expected matches to cover indices 0 and 1, got %v", matches)
+	}
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"fmt"
 	"math"
 	"os"
 	"strings"
@@ -13,13 +14,20 @@ import (
 
 var shimmerStart = time.Now()
 
-// Shimmer renders text with a moving foreground highlight.
-// bg is the background color, dim is the base text color, bright is the highlight color.
-func Shimmer(s string, bg compat.AdaptiveColor, _ compat.AdaptiveColor, _ compat.AdaptiveColor) string {
+// Shimmer renders text with a moving foreground highlight. bg is the
+// background color. dim and bright are not used: the sweep is drawn in
+// grayscale levels (or bold/faint without truecolor). fraction is the
+// determinate progress (0..1); pass math.NaN() to fall back to the
+// indeterminate moving-sweep animation.
+func Shimmer(s string, bg compat.AdaptiveColor, dim compat.AdaptiveColor, bright compat.AdaptiveColor, fraction float64) string {
 	if s == "" {
 		return ""
 	}
 
+	if !math.IsNaN(fraction) {
+		return Progress(s, bg, fraction, -1)
+	}
+
 	runes := []rune(s)
 	n := len(runes)
 	if n == 0 {
@@ -110,6 +118,106 @@ func Shimmer(s string, bg compat.AdaptiveColor, _ compat.AdaptiveColor, _ compat
 	return b.String()
 }
 
+// Progress renders a left-filled gradient bar over s, with the filled
+// portion brightened relative to fraction (0..1, clamped). When
+// etaSeconds is < 0 the output has the same rune width as s. When
+// etaSeconds is >= 0 the label is suffixed with " (NN% · ~Ns)" so the bar
+// doubles as a status line; the suffix is appended after s, not overlaid
+// on it, so the output is wider than s. Degrades to
+// bold/faint modifiers on terminals without COLORTERM=truecolor.
+func Progress(s string, bg compat.AdaptiveColor, fraction float64, etaSeconds int) string {
+	if s == "" {
+		return ""
+	}
+
+	if fraction < 0 {
+		fraction = 0
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	label := s
+	if etaSeconds >= 0 {
+		label = fmt.Sprintf("%s (%d%% · ~%ds)", s, int(math.Round(fraction*100)), etaSeconds)
+	}
+
+	runes := []rune(label)
+	n := len(runes)
+	if n == 0 {
+		return label
+	}
+
+	fillEnd := int(math.Round(fraction * float64(n)))
+	useHex := hasTrueColor()
+
+	type seg struct {
+		useHex bool
+		hex    string
+		bold   bool
+		faint  bool
+		text   string
+	}
+	var segs []seg
+
+	for i, r := range runes {
+		filled := i < fillEnd
+
+		base := 0.35
+		brightness := base
+		if filled {
+			brightness = 0.55 + 0.45*(float64(i+1)/float64(max(fillEnd, 1)))
+		}
+		lvl := int(math.Round(brightness * 255.0))
+		if !useHex {
+			step := 24 // ~11 steps across range for non-truecolor
+			lvl = int(math.Round(float64(lvl)/float64(step))) * step
+		}
+
+		bold := lvl >= 208
+		faint := lvl <= 128
+
+		hex := ""
+		if useHex {
+			if lvl < 0 {
+				lvl = 0
+			}
+			if lvl > 255 {
+				lvl = 255
+			}
+			hex = rgbHex(lvl, lvl, lvl)
+		}
+
+		if len(segs) == 0 {
+			segs = append(segs, seg{useHex: useHex, hex: hex, bold: bold, faint: faint, text: string(r)})
+		} else {
+			last := &segs[len(segs)-1]
+			if last.useHex == useHex && last.hex == hex && last.bold == bold && last.faint == faint {
+				last.text += string(r)
+			} else {
+				segs = append(segs, seg{useHex: useHex, hex: hex, bold: bold, faint: faint, text: string(r)})
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, g := range segs {
+		st := styles.NewStyle().Background(bg)
+		if g.useHex && g.hex != "" {
+			c := compat.AdaptiveColor{Dark: lipgloss.Color(g.hex), Light: lipgloss.Color(g.hex)}
+			st = st.Foreground(c)
+		}
+		if g.bold {
+			st = st.Bold(true)
+		}
+		if g.faint {
+			st = st.Faint(true)
+		}
+		b.WriteString(st.Render(g.text))
+	}
+	return b.String()
+}
+
 func hasTrueColor() bool {
 	c := strings.ToLower(os.Getenv("COLORTERM"))
 	return strings.Contains(c, "truecolor") || strings.Contains(c, "24bit")
@@ -0,0 +1,63 @@
+package util
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/compat"
+)
+
+func testColor(hex string) compat.AdaptiveColor {
+	return compat.AdaptiveColor{Dark: lipgloss.Color(hex), Light: lipgloss.Color(hex)}
+}
+
+func TestShimmer_EmptyString(t *testing.T) {
+	if got := Shimmer("", testColor("#000000"), testColor("#888888"), testColor("#ffffff"), math.NaN()); got != "" {
+		t.Fatalf("expected empty string, got %q", got)
+	}
+}
+
+func TestShimmer_WithFractionDelegatesToProgress(t *testing.T) {
+	bg, dim, bright := testColor("#000000"), testColor("#888888"), testColor("#ffffff")
+	got := Shimmer("loading", bg, dim, bright, 0.5)
+	want := Progress("loading", bg, 0.5, -1)
+	if got != want {
+		t.Fatalf("expected Shimmer(fraction) to match Progress output, got %q want %q", got, want)
+	}
+}
+
+func TestProgress_EmptyString(t *testing.T) {
+	if got := Progress("", testColor("#000000"), 0.5, 10); got != "" {
+		t.Fatalf("expected empty string, got %q", got)
+	}
+}
+
+func TestProgress_ClampsFraction(t *testing.T) {
+	over := Progress("bar", testColor("#000000"), 2.0, -1)
+	one := Progress("bar", testColor("#000000"), 1.0, -1)
+	if over != one {
+		t.Fatalf("expected fraction > 1 to clamp to 1.0")
+	}
+
+	under := Progress("bar", testColor("#000000"), -5.0, -1)
+	zero := Progress("bar", testColor("#000000"), 0.0, -1)
+	if under != zero {
+		t.Fatalf("expected fraction < 0 to clamp to 0.0")
+	}
+}
+
+func TestProgress_AppendsEtaLabel(t *testing.T) {
+	got := Progress("Building", testColor("#000000"), 0.42, 12)
+	if !strings.Contains(got, "42%") || !strings.Contains(got, "~12s") {
+		t.Fatalf("expected eta label with 42%% and ~12s, got %q", got)
+	}
+}
+
+func TestProgress_NoEtaLabelWhenNegative(t *testing.T) {
+	got := Progress("Building", testColor("#000000"), 0.42, -1)
+	if strings.Contains(got, "%") {
+		t.Fatalf("expected no percentage label when etaSeconds < 0, got %q", got)
+	}
+}